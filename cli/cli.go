@@ -38,6 +38,7 @@ Commands:
         help     show usage for a specific command
         node     run a KORD node
         load     load quads into KORD
+        version  print the version and build information
 
 See 'kord help <command>' for more information on a specific command.
 `[1:]
@@ -53,7 +54,7 @@ func Run(ctx *Context, argv ...string) error {
 		ctx.Stderr = os.Stderr
 	}
 
-	v, err := docopt.Parse(usage, argv, true, "0.0.1", true)
+	v, err := docopt.Parse(usage, argv, true, Version, true)
 	if err != nil {
 		return err
 	}
@@ -22,8 +22,10 @@ package cli
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -34,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kord-network/go-kord/registry"
 )
 
 func init() {
@@ -107,6 +110,77 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestLoadBatchSize(t *testing.T) {
+	for _, size := range []string{"0", "-1", "abc", "1000001", "99999999999999"} {
+		cliCtx := NewContext(context.Background())
+		err := Run(
+			cliCtx,
+			"graph",
+			"load",
+			"--url", n.ipcPath,
+			"--keystore", n.keystore,
+			"--batch-size", size,
+			registry.DevAddr.Hex(),
+			"../graph/data/testdata.nq",
+		)
+		if err == nil {
+			t.Fatalf("expected error loading with --batch-size %s", size)
+		}
+		if expected := fmt.Sprintf("invalid --batch-size: %s", size); err.Error() != expected {
+			t.Fatalf("expected error %q, got %q", expected, err)
+		}
+	}
+}
+
+func TestSuggestGasPrice(t *testing.T) {
+	maxPrice := big.NewInt(100)
+	for _, test := range []struct {
+		suggested int64
+		err       error
+		expected  int64
+	}{
+		{suggested: 40, expected: 40},
+		{suggested: 100, expected: 100},
+		{suggested: 500, expected: 100},
+		{err: errors.New("oracle error")},
+	} {
+		oracle := &testGasPriceOracle{price: big.NewInt(test.suggested), err: test.err}
+		price, err := suggestGasPrice(context.Background(), oracle, maxPrice)
+		if test.err != nil {
+			if err != test.err {
+				t.Fatalf("expected error %q, got %v", test.err, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if price.Int64() != test.expected {
+			t.Fatalf("expected suggested price %d to give gas price %d, got %s", test.suggested, test.expected, price)
+		}
+	}
+}
+
+type testGasPriceOracle struct {
+	price *big.Int
+	err   error
+}
+
+func (o *testGasPriceOracle) SuggestPrice(context.Context) (*big.Int, error) {
+	return o.price, o.err
+}
+
+func TestVersion(t *testing.T) {
+	cliCtx := NewContext(context.Background())
+	var stdout bytes.Buffer
+	cliCtx.Stdout = &stdout
+	if err := Run(cliCtx, "version"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(stdout.String(), Version) {
+		t.Fatalf("expected version output to contain %q, got %q", Version, stdout.String())
+	}
+}
+
 func TestDapp(t *testing.T) {
 	// create an ID
 	cliCtx := NewContext(context.Background())
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 
 	"github.com/cayleygraph/cayley/graph"
 	"github.com/cayleygraph/cayley/quad"
@@ -50,9 +51,14 @@ Create, update or query a KORD graph.
 options:
         -u, --url <url>        URL of the KORD node
 	-k, --keystore <dir>   Keystore directory
+	-b, --batch-size <n>   Number of quads to apply per transaction when loading, at most 1000000 [default: 10000]
 `[1:])
 }
 
+// maxBatchSize is the maximum --batch-size accepted by 'kord graph load',
+// bounding the size of the quad buffer allocated for each batch.
+const maxBatchSize = 1000000
+
 func RunGraph(ctx *Context) error {
 	switch {
 	case ctx.Args.Bool("create"):
@@ -97,14 +103,19 @@ func RunGraphLoad(ctx *Context) error {
 	}
 	id := common.HexToAddress(idArg)
 
+	batchSize, err := strconv.Atoi(ctx.Args.String("--batch-size"))
+	if err != nil || batchSize <= 0 || batchSize > maxBatchSize {
+		return fmt.Errorf("invalid --batch-size: %s", ctx.Args.String("--batch-size"))
+	}
+
 	client, err := ctx.Client()
 	if err != nil {
 		return err
 	}
 
 	file := ctx.Args.String("<file>")
-	log.Info("loading quads", "id", id, "file", file)
-	count, err := loadQuads(ctx, client, id, file)
+	log.Info("loading quads", "id", id, "file", file, "batch", batchSize)
+	count, err := loadQuads(ctx, client, id, file, batchSize)
 	if err != nil {
 		return err
 	}
@@ -123,7 +134,7 @@ func RunGraphLoad(ctx *Context) error {
 	return nil
 }
 
-func loadQuads(ctx *Context, client *kord.Client, id common.Address, file string) (int, error) {
+func loadQuads(ctx *Context, client *kord.Client, id common.Address, file string, batchSize int) (int, error) {
 	var in io.Reader
 	f, err := os.Open(file)
 	if err != nil {
@@ -150,7 +161,7 @@ func loadQuads(ctx *Context, client *kord.Client, id common.Address, file string
 		return 0, err
 	}
 	qr := nquads.NewReader(in, false)
-	return quad.CopyBatch(graph.NewWriter(qw), qr, quad.DefaultBatch)
+	return quad.CopyBatch(graph.NewWriter(qw), qr, batchSize)
 }
 
 func setGraph(ctx *Context, client *kord.Client, id common.Address, hash common.Hash) error {
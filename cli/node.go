@@ -21,6 +21,7 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -56,7 +57,7 @@ var testnetBootnodes = []string{
 
 func init() {
 	registerCommand("node", RunNode, `
-usage: kord node [--datadir <dir>] [--config <path>] [--dev] [--testnet] [--mine] [--root-dapp <uri>] [--cors-domain <domain>...]
+usage: kord node [--datadir <dir>] [--config <path>] [--dev] [--testnet] [--mine] [--gas-oracle] [--root-dapp <uri>] [--cors-domain <domain>...]
 
 Run a KORD node.
 
@@ -66,6 +67,7 @@ options:
 	--dev                       Run a dev node
 	--testnet                   Connect to the testnet
 	--mine                      Mine the Ethereum chain
+	--gas-oracle                Lower the mining gas price from recent blocks (requires --mine or --dev)
 	--root-dapp <uri>           Dapp to serve at root of KORD API
 	--cors-domain <domain>...   The allowed CORS domains
 `[1:])
@@ -103,6 +105,10 @@ func RunNode(ctx *Context) error {
 		cfg.Kord.CORSDomains = domains
 	}
 
+	if ctx.Args.Bool("--gas-oracle") && !ctx.Args.Bool("--mine") && !ctx.Args.Bool("--dev") {
+		return errors.New("--gas-oracle requires --mine or --dev")
+	}
+
 	if ctx.Args.Bool("--dev") && ctx.Args.Bool("--testnet") {
 		return errors.New("--dev and --testnet cannot both be set")
 	} else if ctx.Args.Bool("--dev") {
@@ -159,6 +165,12 @@ func RunNode(ctx *Context) error {
 			stack.Stop()
 			return err
 		}
+		if ctx.Args.Bool("--gas-oracle") {
+			if err := startGasOracle(ctx, stack, &cfg); err != nil {
+				stack.Stop()
+				return err
+			}
+		}
 	}
 
 	if ctx.Args.Bool("--dev") {
@@ -259,7 +271,7 @@ func defaultConfig() config {
 func defaultNodeConfig() node.Config {
 	cfg := node.DefaultConfig
 	cfg.Name = "kord"
-	cfg.Version = "0.0.1"
+	cfg.Version = Version
 	cfg.HTTPModules = append(cfg.HTTPModules, "eth")
 	cfg.WSModules = append(cfg.WSModules, "eth")
 	cfg.IPCPath = "kord.ipc"
@@ -329,6 +341,65 @@ func startMining(stack *node.Node, cfg *config) error {
 	return nil
 }
 
+// chainHeadChanSize is the size of the channel used to receive chain head
+// events, matching the Ethereum transaction pool.
+const chainHeadChanSize = 10
+
+// startGasOracle starts a background updater which sets the transaction pool
+// gas price to the price suggested by the Ethereum gas price oracle (which is
+// configured by Eth.GPO) each time the chain head changes, never exceeding
+// the configured Eth.GasPrice.
+func startGasOracle(ctx context.Context, stack *node.Node, cfg *config) error {
+	var ethereum *eth.Ethereum
+	if err := stack.Service(&ethereum); err != nil {
+		return fmt.Errorf("error getting Ethereum service: %s", err)
+	}
+	heads := make(chan core.ChainHeadEvent, chainHeadChanSize)
+	sub := ethereum.BlockChain().SubscribeChainHeadEvent(heads)
+	go func() {
+		defer sub.Unsubscribe()
+		price := cfg.Eth.GasPrice
+		for {
+			select {
+			case <-heads:
+				newPrice, err := suggestGasPrice(ctx, ethereum.ApiBackend, cfg.Eth.GasPrice)
+				if err != nil {
+					log.Warn("error getting suggested gas price", "err", err)
+					continue
+				}
+				if newPrice.Cmp(price) != 0 {
+					price = newPrice
+					ethereum.TxPool().SetGasPrice(price)
+				}
+			case <-sub.Err():
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// gasPriceOracle suggests gas prices, implemented by eth.EthApiBackend.
+type gasPriceOracle interface {
+	SuggestPrice(ctx context.Context) (*big.Int, error)
+}
+
+// suggestGasPrice returns the price suggested by the given oracle, capped at
+// maxPrice so that the transaction pool never evicts or rejects transactions
+// which pay the configured gas price.
+func suggestGasPrice(ctx context.Context, oracle gasPriceOracle, maxPrice *big.Int) (*big.Int, error) {
+	price, err := oracle.SuggestPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if price.Cmp(maxPrice) > 0 {
+		return maxPrice, nil
+	}
+	return price, nil
+}
+
 func testnetGenesisBlock() *core.Genesis {
 	config := *params.AllCliqueProtocolChanges
 	config.ChainId = big.NewInt(1035)
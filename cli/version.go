@@ -0,0 +1,52 @@
+// This file is part of the go-kord library.
+//
+// Copyright (C) 2018 JAAK MUSIC LTD
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+// If you have any questions please contact yo@jaak.io
+
+package cli
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Version is the version of the kord binary.
+const Version = "0.0.1"
+
+// GitCommit is the git commit the kord binary was built from, set at build
+// time using:
+//
+//	go build -ldflags "-X github.com/kord-network/go-kord/cli.GitCommit=$(git rev-parse HEAD)"
+var GitCommit string
+
+func init() {
+	registerCommand("version", RunVersion, `
+usage: kord version
+
+Print the kord version and build information.
+`[1:])
+}
+
+func RunVersion(ctx *Context) error {
+	fmt.Fprintln(ctx.Stdout, "Version:   ", Version)
+	if GitCommit != "" {
+		fmt.Fprintln(ctx.Stdout, "Git Commit:", GitCommit)
+	}
+	fmt.Fprintln(ctx.Stdout, "Go Version:", runtime.Version())
+	fmt.Fprintln(ctx.Stdout, "OS/Arch:   ", runtime.GOOS+"/"+runtime.GOARCH)
+	return nil
+}
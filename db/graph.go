@@ -23,6 +23,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cayleygraph/cayley/clog"
 	"github.com/cayleygraph/cayley/graph"
@@ -73,6 +74,9 @@ var QueryDialect = cayleysql.QueryDialect{
 // runTx is Cayley SQL quadstore function which applies updates using the given
 // transaction.
 //
+// Existing nodes and quads are detected using SQLite uniqueness errors (see
+// isUniqueErr), since graph databases are always SQLite files.
+//
 // See the Cayley PostgreSQL implementation:
 //
 // https://github.com/cayleygraph/cayley/blob/v0.7.1/graph/sql/postgres/postgres.go#L114-L204
@@ -95,15 +99,7 @@ func runTx(tx *sql.Tx, nodes []graphlog.NodeUpdate, quads []graphlog.QuadUpdate,
 		values = append([]interface{}{n.RefInc}, values...)
 		stmt, ok := insertValue[nodeKey]
 		if !ok {
-			var ph = make([]string, len(values))
-			for i := range ph {
-				ph[i] = QueryDialect.Placeholder(i + 1)
-			}
-			stmt, err = tx.Prepare(fmt.Sprintf(
-				`INSERT INTO nodes(refs, hash, %s) VALUES (%s)`,
-				strings.Join(nodeKey.Columns(), ", "),
-				strings.Join(ph, ", "),
-			))
+			stmt, err = tx.Prepare(insertNodeSQL(nodeKey.Columns()))
 			if err != nil {
 				return err
 			}
@@ -113,7 +109,7 @@ func runTx(tx *sql.Tx, nodes []graphlog.NodeUpdate, quads []graphlog.QuadUpdate,
 		_, err = stmt.Exec(values...)
 		if isUniqueErr(err) {
 			if updateValue == nil {
-				updateValue, err = tx.Prepare(`UPDATE nodes SET refs = $1 WHERE hash = $2`)
+				updateValue, err = tx.Prepare(updateNodeSQL())
 				if err != nil {
 					return err
 				}
@@ -134,21 +130,20 @@ func runTx(tx *sql.Tx, nodes []graphlog.NodeUpdate, quads []graphlog.QuadUpdate,
 		if d.Del {
 			return fmt.Errorf("unexpected quad delete: %v", d)
 		}
-		dirs := make([]interface{}, 0, len(quad.Directions))
+		values := make([]interface{}, 0, len(quad.Directions)+1)
 		for _, h := range d.Quad.Dirs() {
-			dirs = append(dirs, cayleysql.NodeHash{h}.SQLValue())
+			values = append(values, cayleysql.NodeHash{h}.SQLValue())
 		}
+		values = append(values, time.Now().UTC())
 		if insertQuad == nil {
 			var err error
-			insertQuad, err = tx.Prepare(
-				`INSERT INTO quads(subject_hash, predicate_hash, object_hash, label_hash, ts) VALUES ($1, $2, $3, $4, datetime('now'))`,
-			)
+			insertQuad, err = tx.Prepare(insertQuadSQL())
 			if err != nil {
 				return err
 			}
 			defer insertQuad.Close()
 		}
-		_, err := insertQuad.Exec(dirs...)
+		_, err := insertQuad.Exec(values...)
 		if isUniqueErr(err) {
 			if !opts.IgnoreDup {
 				return &graph.DeltaError{Err: graph.ErrQuadExists}
@@ -161,6 +156,62 @@ func runTx(tx *sql.Tx, nodes []graphlog.NodeUpdate, quads []graphlog.QuadUpdate,
 	return nil
 }
 
+// insertNodeSQL returns the statement used by runTx to insert a node with the
+// given value columns, quoting identifiers and generating placeholders using
+// QueryDialect.
+func insertNodeSQL(columns []string) string {
+	columns = append([]string{"refs", "hash"}, columns...)
+	return fmt.Sprintf(
+		`INSERT INTO %s(%s) VALUES (%s)`,
+		QueryDialect.FieldQuote("nodes"),
+		quoteFields(columns),
+		placeholders(len(columns)),
+	)
+}
+
+// updateNodeSQL returns the statement used by runTx to update the ref count
+// of an existing node, quoting identifiers and generating placeholders using
+// QueryDialect.
+func updateNodeSQL() string {
+	return fmt.Sprintf(
+		`UPDATE %s SET %s = %s WHERE %s = %s`,
+		QueryDialect.FieldQuote("nodes"),
+		QueryDialect.FieldQuote("refs"),
+		QueryDialect.Placeholder(1),
+		QueryDialect.FieldQuote("hash"),
+		QueryDialect.Placeholder(2),
+	)
+}
+
+// insertQuadSQL returns the statement used by runTx to insert a quad and its
+// timestamp, quoting identifiers and generating placeholders using
+// QueryDialect.
+func insertQuadSQL() string {
+	columns := []string{"subject_hash", "predicate_hash", "object_hash", "label_hash", "ts"}
+	return fmt.Sprintf(
+		`INSERT INTO %s(%s) VALUES (%s)`,
+		QueryDialect.FieldQuote("quads"),
+		quoteFields(columns),
+		placeholders(len(columns)),
+	)
+}
+
+func quoteFields(fields []string) string {
+	quoted := make([]string, len(fields))
+	for i, field := range fields {
+		quoted[i] = QueryDialect.FieldQuote(field)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func placeholders(n int) string {
+	ph := make([]string, n)
+	for i := range ph {
+		ph[i] = QueryDialect.Placeholder(i + 1)
+	}
+	return strings.Join(ph, ", ")
+}
+
 // isUniqueErr determines whether an error is a SQLite3 uniqueness error.
 func isUniqueErr(err error) bool {
 	e, ok := err.(sqlite3.Error)
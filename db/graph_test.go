@@ -0,0 +1,46 @@
+// This file is part of the go-kord library.
+//
+// Copyright (C) 2018 JAAK MUSIC LTD
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+// If you have any questions please contact yo@jaak.io
+
+package db
+
+import "testing"
+
+func TestRunTxSQL(t *testing.T) {
+	for _, test := range []struct {
+		sql      string
+		expected string
+	}{
+		{
+			sql:      insertNodeSQL([]string{"value_string", "iri"}),
+			expected: `INSERT INTO "nodes"("refs", "hash", "value_string", "iri") VALUES ($1, $2, $3, $4)`,
+		},
+		{
+			sql:      updateNodeSQL(),
+			expected: `UPDATE "nodes" SET "refs" = $1 WHERE "hash" = $2`,
+		},
+		{
+			sql:      insertQuadSQL(),
+			expected: `INSERT INTO "quads"("subject_hash", "predicate_hash", "object_hash", "label_hash", "ts") VALUES ($1, $2, $3, $4, $5)`,
+		},
+	} {
+		if test.sql != test.expected {
+			t.Fatalf("unexpected SQL:\nexpected: %s\nactual:   %s", test.expected, test.sql)
+		}
+	}
+}
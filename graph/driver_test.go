@@ -27,6 +27,7 @@ import (
 
 	"github.com/cayleygraph/cayley/graph"
 	"github.com/cayleygraph/cayley/graph/sql/sqltest"
+	"github.com/cayleygraph/cayley/quad"
 	"github.com/kord-network/go-kord/testutil"
 )
 
@@ -57,3 +58,44 @@ func TestSQLBackend(t *testing.T) {
 func newTestDB(t testing.TB) (string, graph.Options, func()) {
 	return fmt.Sprintf("%d.test.kord", rand.Int()), nil, func() {}
 }
+
+// BenchmarkLoadQuads measures loading a synthetic stream of quads into a
+// graph using different batch sizes, each batch being applied in a single
+// SQL transaction.
+func BenchmarkLoadQuads(b *testing.B) {
+	quads := make([]quad.Quad, 10000)
+	for i := range quads {
+		quads[i] = quad.MakeIRI(
+			fmt.Sprintf("kord:subject/%d", i%100),
+			"kord:property",
+			fmt.Sprintf("kord:object/%d", i),
+			"",
+		)
+	}
+	for _, size := range []int{1, 100, 1000, 10000} {
+		b.Run(fmt.Sprintf("batch-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				name, _, _ := newTestDB(b)
+				if _, err := testDriver.Create(name); err != nil {
+					b.Fatal(err)
+				}
+				qs, err := graph.NewQuadStore(testDriver.name, name, nil)
+				if err != nil {
+					b.Fatal(err)
+				}
+				qw, err := graph.NewQuadWriter("single", qs, nil)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.StartTimer()
+				if _, err := quad.CopyBatch(graph.NewWriter(qw), quad.NewReader(quads), size); err != nil {
+					b.Fatal(err)
+				}
+				b.StopTimer()
+				qs.Close()
+				b.StartTimer()
+			}
+		})
+	}
+}